@@ -0,0 +1,232 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm)
+// go-aah/aah source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package aah
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"text/template"
+	"time"
+
+	"aahframework.org/ahttp.v0"
+	"aahframework.org/config.v0"
+	"aahframework.org/log.v0"
+)
+
+// authenticatedUserContextKey is the `context.Context` key aah's
+// authentication middleware (once it exists) uses to publish the
+// identified caller, and `AccessLog` reads back via `authenticatedUser`.
+type authenticatedUserContextKey struct{}
+
+// WithAuthenticatedUser method returns a shallow copy of r whose context
+// carries the given authenticated principal, so `AccessLog` (and any
+// other downstream middleware) can report it. An aah authentication
+// middleware should call this once it has identified the caller.
+func WithAuthenticatedUser(r *http.Request, user string) *http.Request {
+	return r.WithContext(context.WithValue(r.Context(), authenticatedUserContextKey{}, user))
+}
+
+// accessLogEntry is the data available to the `json` format and to a
+// custom `access_log.pattern` template.
+type accessLogEntry struct {
+	RemoteIP     string
+	User         string
+	Time         time.Time
+	Method       string
+	RequestURI   string
+	Proto        string
+	Status       int
+	BytesWritten int
+	Referer      string
+	UserAgent    string
+	Duration     time.Duration
+}
+
+// AccessLog is the built-in access-log subsystem: it routes one record
+// per completed HTTP request through a dedicated `*log.Logger`, formatted
+// as `common` (CLF), `combined` (Apache combined) or `json`, or via a
+// custom `text/template` supplied in `access_log.pattern`.
+type AccessLog struct {
+	logger         *log.Logger
+	format         string
+	tmpl           *template.Template
+	trustedProxies []*net.IPNet
+}
+
+// NewAccessLog method creates an `AccessLog` that writes through the
+// given `logger` (so applications can point it at its own file receiver
+// with its own rotation, independent of the app's main logger). The
+// logger is also `log.Register`ed under `"access"`, making its level
+// controllable at runtime via the admin loggers endpoint.
+func NewAccessLog(logger *log.Logger, cfg *config.Config) (*AccessLog, error) {
+	al := &AccessLog{
+		logger: logger,
+		format: strings.ToLower(cfg.StringDefault("access_log.format", "combined")),
+	}
+
+	if pattern := cfg.StringDefault("access_log.pattern", ""); pattern != "" {
+		tmpl, err := template.New("access_log").Parse(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("aah: invalid access_log.pattern: %v", err)
+		}
+		al.tmpl = tmpl
+	}
+
+	for _, cidr := range strings.Split(cfg.StringDefault("access_log.trusted_proxies", ""), ",") {
+		cidr = strings.TrimSpace(cidr)
+		if cidr == "" {
+			continue
+		}
+		if _, ipnet, err := net.ParseCIDR(cidr); err == nil {
+			al.trustedProxies = append(al.trustedProxies, ipnet)
+		}
+	}
+
+	log.Register("access", logger)
+
+	return al, nil
+}
+
+// Middleware method returns an `http.Handler` that wraps `next`, writing
+// one access-log record after every request completes. Response status
+// and byte count come from `ahttp.ResponseWriter`, so `next` must not
+// bypass it.
+func (al *AccessLog) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+
+		aw, ok := w.(ahttp.ResponseWriter)
+		if !ok {
+			aw = ahttp.WrapResponseWriter(w)
+		}
+
+		next.ServeHTTP(aw, r)
+
+		al.write(aw, r, start)
+	})
+}
+
+// write builds the access-log entry for a completed request and emits it
+// in the configured format.
+func (al *AccessLog) write(w ahttp.ResponseWriter, r *http.Request, start time.Time) {
+	entry := accessLogEntry{
+		RemoteIP:     al.remoteIP(r),
+		User:         authenticatedUser(r),
+		Time:         start,
+		Method:       r.Method,
+		RequestURI:   r.RequestURI,
+		Proto:        r.Proto,
+		Status:       w.Status(),
+		BytesWritten: w.BytesWritten(),
+		Referer:      r.Referer(),
+		UserAgent:    r.UserAgent(),
+		Duration:     time.Since(start),
+	}
+
+	switch {
+	case al.tmpl != nil:
+		var buf strings.Builder
+		if err := al.tmpl.Execute(&buf, entry); err != nil {
+			al.logger.Errorf("access log template: %v", err)
+			return
+		}
+		al.logger.Print(buf.String())
+	case al.format == "json":
+		al.logger.WithFields(log.Fields{
+			"remote_ip":     entry.RemoteIP,
+			"user":          entry.User,
+			"method":        entry.Method,
+			"request_uri":   entry.RequestURI,
+			"proto":         entry.Proto,
+			"status":        entry.Status,
+			"bytes_written": entry.BytesWritten,
+			"referer":       entry.Referer,
+			"user_agent":    entry.UserAgent,
+			"duration_ms":   entry.Duration.Seconds() * 1000,
+		}).Info("access")
+	case al.format == "common":
+		al.logger.Print(formatCommon(entry))
+	default: // "combined"
+		al.logger.Print(formatCombined(entry))
+	}
+}
+
+// formatCommon renders the entry as NCSA Common Log Format.
+func formatCommon(e accessLogEntry) string {
+	user := e.User
+	if user == "" {
+		user = "-"
+	}
+	return fmt.Sprintf(`%s - %s [%s] "%s %s %s" %d %d`,
+		e.RemoteIP, user, e.Time.Format("02/Jan/2006:15:04:05 -0700"),
+		e.Method, e.RequestURI, e.Proto, e.Status, e.BytesWritten)
+}
+
+// formatCombined renders the entry as Apache Combined Log Format - CLF
+// plus referer and user-agent.
+func formatCombined(e accessLogEntry) string {
+	referer := e.Referer
+	if referer == "" {
+		referer = "-"
+	}
+	userAgent := e.UserAgent
+	if userAgent == "" {
+		userAgent = "-"
+	}
+	return fmt.Sprintf(`%s "%s" "%s"`, formatCommon(e), referer, userAgent)
+}
+
+// remoteIP method returns the client IP, honoring `X-Forwarded-For`/
+// `Forwarded` only when the direct peer is in `access_log.trusted_proxies`.
+func (al *AccessLog) remoteIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+
+	if !al.isTrustedProxy(host) {
+		return host
+	}
+
+	if fwd := r.Header.Get("X-Forwarded-For"); fwd != "" {
+		parts := strings.Split(fwd, ",")
+		return strings.TrimSpace(parts[0])
+	}
+
+	if fwd := r.Header.Get("Forwarded"); fwd != "" {
+		for _, pair := range strings.Split(fwd, ";") {
+			pair = strings.TrimSpace(pair)
+			if strings.HasPrefix(strings.ToLower(pair), "for=") {
+				return strings.Trim(pair[len("for="):], `"`)
+			}
+		}
+	}
+
+	return host
+}
+
+func (al *AccessLog) isTrustedProxy(host string) bool {
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return false
+	}
+	for _, ipnet := range al.trustedProxies {
+		if ipnet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// authenticatedUser returns the authenticated principal stashed on r via
+// `WithAuthenticatedUser`, or "" if no authentication middleware ran (or
+// the caller wasn't authenticated).
+func authenticatedUser(r *http.Request) string {
+	user, _ := r.Context().Value(authenticatedUserContextKey{}).(string)
+	return user
+}
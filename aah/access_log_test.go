@@ -0,0 +1,95 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm)
+// go-aah/aah source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package aah
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"aahframework.org/test.v0/assert"
+)
+
+func TestFormatCommon(t *testing.T) {
+	e := accessLogEntry{
+		RemoteIP:     "127.0.0.1",
+		Time:         time.Date(2016, 7, 3, 19, 22, 11, 0, time.UTC),
+		Method:       http.MethodGet,
+		RequestURI:   "/login",
+		Proto:        "HTTP/1.1",
+		Status:       200,
+		BytesWritten: 42,
+	}
+
+	assert.Equal(t, `127.0.0.1 - - [03/Jul/2016:19:22:11 +0000] "GET /login HTTP/1.1" 200 42`, formatCommon(e))
+
+	e.User = "jeeva"
+	assert.Equal(t, `127.0.0.1 - jeeva [03/Jul/2016:19:22:11 +0000] "GET /login HTTP/1.1" 200 42`, formatCommon(e))
+}
+
+func TestFormatCombined(t *testing.T) {
+	e := accessLogEntry{
+		RemoteIP:   "127.0.0.1",
+		Time:       time.Date(2016, 7, 3, 19, 22, 11, 0, time.UTC),
+		Method:     http.MethodGet,
+		RequestURI: "/login",
+		Proto:      "HTTP/1.1",
+		Status:     200,
+	}
+
+	assert.Equal(t, `127.0.0.1 - - [03/Jul/2016:19:22:11 +0000] "GET /login HTTP/1.1" 200 0 "-" "-"`, formatCombined(e))
+
+	e.Referer = "https://example.com"
+	e.UserAgent = "curl/7.54"
+	assert.Equal(t, `127.0.0.1 - - [03/Jul/2016:19:22:11 +0000] "GET /login HTTP/1.1" 200 0 "https://example.com" "curl/7.54"`, formatCombined(e))
+}
+
+func TestAccessLogRemoteIPDirect(t *testing.T) {
+	al := &AccessLog{}
+
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.RemoteAddr = "203.0.113.5:54321"
+	r.Header.Set("X-Forwarded-For", "198.51.100.7")
+
+	assert.Equal(t, "203.0.113.5", al.remoteIP(r))
+}
+
+func TestAccessLogRemoteIPTrustedProxy(t *testing.T) {
+	_, ipnet, err := net.ParseCIDR("203.0.113.0/24")
+	assert.Nil(t, err)
+	al := &AccessLog{trustedProxies: []*net.IPNet{ipnet}}
+
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.RemoteAddr = "203.0.113.5:54321"
+	r.Header.Set("X-Forwarded-For", "198.51.100.7, 203.0.113.5")
+
+	assert.Equal(t, "198.51.100.7", al.remoteIP(r))
+
+	r2 := httptest.NewRequest(http.MethodGet, "/", nil)
+	r2.RemoteAddr = "203.0.113.5:54321"
+	r2.Header.Set("Forwarded", `for="198.51.100.9";proto=https`)
+
+	assert.Equal(t, "198.51.100.9", al.remoteIP(r2))
+}
+
+func TestAccessLogIsTrustedProxy(t *testing.T) {
+	_, ipnet, err := net.ParseCIDR("203.0.113.0/24")
+	assert.Nil(t, err)
+	al := &AccessLog{trustedProxies: []*net.IPNet{ipnet}}
+
+	assert.True(t, al.isTrustedProxy("203.0.113.5"))
+	assert.False(t, al.isTrustedProxy("198.51.100.7"))
+	assert.False(t, al.isTrustedProxy("not-an-ip"))
+}
+
+func TestAuthenticatedUser(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	assert.Equal(t, "", authenticatedUser(r))
+
+	r = WithAuthenticatedUser(r, "jeeva")
+	assert.Equal(t, "jeeva", authenticatedUser(r))
+}
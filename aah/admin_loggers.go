@@ -0,0 +1,148 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm)
+// go-aah/aah source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package aah
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"aahframework.org/config.v0"
+	"aahframework.org/log.v0"
+)
+
+// AdminAccessControl is the access-control hook invoked before every
+// request into the `aah` admin subsystem (`/aah/...`). Applications
+// enabling `admin.enable` must call `SetAdminAccessControl` with a hook
+// that authenticates/authorizes the operator; the default denies all
+// requests.
+type AdminAccessControl func(r *http.Request) bool
+
+var adminAccessControl AdminAccessControl = func(r *http.Request) bool { return false }
+
+// SetAdminAccessControl method sets the access-control hook used to guard
+// the `aah` admin subsystem. It must be called before the admin handler
+// is exercised, typically during application bootstrap.
+func SetAdminAccessControl(fn AdminAccessControl) {
+	if fn != nil {
+		adminAccessControl = fn
+	}
+}
+
+// loggerLevelInfo is the wire representation of a registered logger's
+// current vs. configured level, returned by `GET /aah/loggers`.
+type loggerLevelInfo struct {
+	Name            string `json:"name"`
+	Level           string `json:"level"`
+	ConfiguredLevel string `json:"configured_level"`
+}
+
+// setLoggerLevelReq is the expected body of `POST /aah/loggers/:name`.
+type setLoggerLevelReq struct {
+	Level string `json:"level"`
+}
+
+// AdminLoggersHandler returns the `http.Handler` for the `aah` admin
+// loggers subsystem - `GET /aah/loggers`, `POST /aah/loggers/:name` and
+// `DELETE /aah/loggers/:name`. It is mounted into the admin router group
+// only when `admin.enable = true` in `aah.conf`; every request is first
+// passed through `adminAccessControl`.
+func AdminLoggersHandler(cfg *config.Config) http.Handler {
+	mux := http.NewServeMux()
+
+	if !cfg.BoolDefault("admin.enable", false) {
+		return mux
+	}
+
+	mux.HandleFunc("/aah/loggers", func(w http.ResponseWriter, r *http.Request) {
+		if !adminAccessControl(r) {
+			writeAdminError(w, http.StatusForbidden, "access denied")
+			return
+		}
+		if r.Method != http.MethodGet {
+			writeAdminError(w, http.StatusMethodNotAllowed, "method not allowed")
+			return
+		}
+		listLoggers(w)
+	})
+
+	mux.HandleFunc("/aah/loggers/", func(w http.ResponseWriter, r *http.Request) {
+		if !adminAccessControl(r) {
+			writeAdminError(w, http.StatusForbidden, "access denied")
+			return
+		}
+
+		name := strings.TrimPrefix(r.URL.Path, "/aah/loggers/")
+		if name == "" {
+			writeAdminError(w, http.StatusBadRequest, "logger name is required")
+			return
+		}
+
+		l, found := log.Loggers()[name]
+		if !found {
+			writeAdminError(w, http.StatusNotFound, "logger not found: "+name)
+			return
+		}
+
+		switch r.Method {
+		case http.MethodPost:
+			setLoggerLevel(w, r, name, l)
+		case http.MethodDelete:
+			resetLoggerLevel(w, name, l)
+		default:
+			writeAdminError(w, http.StatusMethodNotAllowed, "method not allowed")
+		}
+	})
+
+	return mux
+}
+
+// listLoggers writes the current vs. configured level of every registered
+// logger as a JSON array.
+func listLoggers(w http.ResponseWriter) {
+	loggers := log.Loggers()
+	list := make([]loggerLevelInfo, 0, len(loggers))
+	for name, l := range loggers {
+		list = append(list, loggerLevelInfo{Name: name, Level: l.Level(), ConfiguredLevel: l.ConfiguredLevel()})
+	}
+	writeAdminJSON(w, http.StatusOK, list)
+}
+
+// setLoggerLevel changes the named logger's level at runtime per the
+// request body, e.g. `{"level":"DEBUG"}`.
+func setLoggerLevel(w http.ResponseWriter, r *http.Request, name string, l *log.Logger) {
+	var req setLoggerLevelReq
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeAdminError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if err := l.SetLevel(req.Level); err != nil {
+		writeAdminError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	writeAdminJSON(w, http.StatusOK, loggerLevelInfo{Name: name, Level: l.Level(), ConfiguredLevel: l.ConfiguredLevel()})
+}
+
+// resetLoggerLevel resets the named logger back to its originally
+// configured level.
+func resetLoggerLevel(w http.ResponseWriter, name string, l *log.Logger) {
+	if err := l.SetLevel(l.ConfiguredLevel()); err != nil {
+		writeAdminError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	writeAdminJSON(w, http.StatusOK, loggerLevelInfo{Name: name, Level: l.Level(), ConfiguredLevel: l.ConfiguredLevel()})
+}
+
+func writeAdminJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeAdminError(w http.ResponseWriter, status int, msg string) {
+	writeAdminJSON(w, status, map[string]string{"error": msg})
+}
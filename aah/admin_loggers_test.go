@@ -0,0 +1,99 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm)
+// go-aah/aah source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package aah
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"aahframework.org/config.v0"
+	"aahframework.org/log.v0"
+	"aahframework.org/test.v0/assert"
+)
+
+func newAdminTestLogger(t *testing.T, name string) *log.Logger {
+	cfg, err := config.ParseString(`log { level = "INFO" }`)
+	assert.Nil(t, err)
+	l, err := log.New(cfg)
+	assert.Nil(t, err)
+	log.Register(name, l)
+	return l
+}
+
+func TestAdminLoggersHandlerAccessDenied(t *testing.T) {
+	cfg, _ := config.ParseString(`admin { enable = true }`)
+	SetAdminAccessControl(func(r *http.Request) bool { return false })
+
+	handler := AdminLoggersHandler(cfg)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/aah/loggers", nil))
+
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
+
+func TestAdminLoggersHandlerDisabled(t *testing.T) {
+	cfg, _ := config.ParseString(`admin { enable = false }`)
+
+	handler := AdminLoggersHandler(cfg)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/aah/loggers", nil))
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestAdminLoggersHandlerListAndSetLevel(t *testing.T) {
+	newAdminTestLogger(t, "admintest")
+
+	cfg, _ := config.ParseString(`admin { enable = true }`)
+	SetAdminAccessControl(func(r *http.Request) bool { return true })
+	handler := AdminLoggersHandler(cfg)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/aah/loggers", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var list []loggerLevelInfo
+	assert.Nil(t, json.NewDecoder(rec.Body).Decode(&list))
+	found := false
+	for _, li := range list {
+		if li.Name == "admintest" {
+			found = true
+			assert.Equal(t, "INFO", li.Level)
+			assert.Equal(t, "INFO", li.ConfiguredLevel)
+		}
+	}
+	assert.True(t, found)
+
+	rec = httptest.NewRecorder()
+	body := strings.NewReader(`{"level":"DEBUG"}`)
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/aah/loggers/admintest", body))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var updated loggerLevelInfo
+	assert.Nil(t, json.NewDecoder(rec.Body).Decode(&updated))
+	assert.Equal(t, "DEBUG", updated.Level)
+	assert.Equal(t, "INFO", updated.ConfiguredLevel)
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodDelete, "/aah/loggers/admintest", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var reset loggerLevelInfo
+	assert.Nil(t, json.NewDecoder(rec.Body).Decode(&reset))
+	assert.Equal(t, "INFO", reset.Level)
+}
+
+func TestAdminLoggersHandlerNotFound(t *testing.T) {
+	cfg, _ := config.ParseString(`admin { enable = true }`)
+	SetAdminAccessControl(func(r *http.Request) bool { return true })
+	handler := AdminLoggersHandler(cfg)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/aah/loggers/doesnotexist", strings.NewReader(`{"level":"DEBUG"}`)))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
@@ -0,0 +1,34 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm)
+// go-aah/aah source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package aah
+
+import (
+	"aahframework.org/config.v0"
+	"aahframework.org/log.v0"
+)
+
+// appLogger is the application-wide logger used by framework-level
+// middleware (`Recover`, etc.) that needs to log but isn't handed a
+// `*log.Logger` of its own, unlike `AccessLog`.
+var appLogger = newDefaultAppLogger()
+
+func newDefaultAppLogger() *log.Logger {
+	cfg, _ := config.ParseString("")
+	l, _ := log.New(cfg)
+	return l
+}
+
+// AppLogger method returns the application-wide logger.
+func AppLogger() *log.Logger {
+	return appLogger
+}
+
+// SetAppLogger method overrides the application-wide logger, e.g. with
+// one configured from `aah.conf` during bootstrap.
+func SetAppLogger(l *log.Logger) {
+	if l != nil {
+		appLogger = l
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm)
+// go-aah/aah source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package aah
+
+import (
+	"net/http"
+
+	"aahframework.org/ahttp.v0"
+	"aahframework.org/config.v0"
+)
+
+// renderGzipLevelUnset is outside the valid `compress/gzip`/`compress/flate`
+// level range (`gzip.HuffmanOnly`..`gzip.BestCompression`, i.e. -2..9), so
+// it safely means "render.gzip.level wasn't set" - as opposed to `0`,
+// which a user may set deliberately to mean `gzip.NoCompression`.
+const renderGzipLevelUnset = -100
+
+// GzipMiddleware wraps `next` so every response is transparently
+// compressed per `ahttp.NewCompressResponseWriter`, controlled by
+// `render.gzip.enable`, `render.gzip.level` and `render.gzip.min_size` in
+// `aah.conf`. When `render.gzip.enable` is false (the default), `next` is
+// returned untouched.
+func GzipMiddleware(cfg *config.Config, next http.Handler) http.Handler {
+	if !cfg.BoolDefault("render.gzip.enable", false) {
+		return next
+	}
+
+	opts := ahttp.Opts{
+		MinSize: cfg.IntDefault("render.gzip.min_size", ahttp.DefaultCompressMinSize),
+	}
+	if level := cfg.IntDefault("render.gzip.level", renderGzipLevelUnset); level != renderGzipLevelUnset {
+		opts.Level = &level
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		aw, ok := w.(ahttp.ResponseWriter)
+		if !ok {
+			aw = ahttp.WrapResponseWriter(w)
+		}
+
+		cw := ahttp.NewCompressResponseWriter(aw, r, opts)
+		defer func() {
+			if c, ok := cw.(*ahttp.CompressResponseWriter); ok {
+				_ = c.Close()
+			}
+		}()
+
+		next.ServeHTTP(cw, r)
+	})
+}
@@ -0,0 +1,108 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm)
+// go-aah/aah source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package aah
+
+import (
+	"fmt"
+	"net/http"
+	"runtime"
+	"strings"
+
+	"aahframework.org/ahttp.v0"
+	"aahframework.org/log.v0"
+)
+
+// PanicHandler is invoked by `Recover` once a panic has been captured and
+// logged, so applications can render their own error view or forward the
+// panic to an external tracker (Sentry, Rollbar, etc.) in addition to the
+// structured log entry. The default writes the standard 500 error view.
+//
+// It takes the raw `http.ResponseWriter`/`*http.Request` rather than
+// aah's own `Context` until the request-engine wiring for `Context` lands
+// alongside this middleware.
+type PanicHandler func(w http.ResponseWriter, r *http.Request, rec interface{}, stack []byte)
+
+var panicHandler PanicHandler = defaultPanicHandler
+
+// SetPanicHandler method overrides the handler invoked after `Recover`
+// captures a panic. Pass `nil` to restore the default (write the standard
+// error view).
+func SetPanicHandler(fn PanicHandler) {
+	if fn == nil {
+		fn = defaultPanicHandler
+	}
+	panicHandler = fn
+}
+
+func defaultPanicHandler(w http.ResponseWriter, r *http.Request, rec interface{}, stack []byte) {
+	http.Error(w, "500 Internal Server Error", http.StatusInternalServerError)
+}
+
+// Recover method returns an `http.Handler` middleware that recovers from
+// a panic in `next`, logs a structured `ERROR` entry (panic value,
+// filtered stack trace, method, path and remote addr as fields - see
+// `Logger.WithFields`) and invokes the pluggable `PanicHandler` to reply.
+// If the response has already been written to (a status or any body
+// bytes), the middleware logs but does not attempt to write a reply. A
+// hijacked connection is not detected: unless a status or body was
+// written first, the `PanicHandler` is still invoked.
+func Recover(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		aw, ok := w.(ahttp.ResponseWriter)
+		if !ok {
+			aw = ahttp.WrapResponseWriter(w)
+		}
+
+		defer func() {
+			rec := recover()
+			if rec == nil {
+				return
+			}
+
+			stack := filteredStack()
+
+			AppLogger().WithFields(log.Fields{
+				"panic":       fmt.Sprint(rec),
+				"stack":       string(stack),
+				"method":      r.Method,
+				"path":        r.URL.Path,
+				"remote_addr": r.RemoteAddr,
+			}).Error("panic recovered")
+
+			if aw.Status() != 0 || aw.BytesWritten() > 0 {
+				// Headers (and possibly a partial body) are already on
+				// the wire - too late to write a reply.
+				return
+			}
+
+			panicHandler(aw, r, rec, stack)
+		}()
+
+		next.ServeHTTP(aw, r)
+	})
+}
+
+// filteredStack captures the current goroutine's stack, dropping the
+// leading `runtime.Callers`/`filteredStack`/recover-closure frames so the
+// trace starts at the panicking application code.
+func filteredStack() []byte {
+	const maxFrames = 64
+	pcs := make([]uintptr, maxFrames)
+	n := runtime.Callers(3, pcs) // skip Callers, filteredStack, the recover closure
+	frames := runtime.CallersFrames(pcs[:n])
+
+	var b strings.Builder
+	for {
+		frame, more := frames.Next()
+		if !strings.HasPrefix(frame.Function, "runtime.") {
+			fmt.Fprintf(&b, "%s\n\t%s:%d\n", frame.Function, frame.File, frame.Line)
+		}
+		if !more {
+			break
+		}
+	}
+
+	return []byte(b.String())
+}
@@ -0,0 +1,90 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm)
+// go-aah/aah source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package aah
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"aahframework.org/test.v0/assert"
+)
+
+func TestFilteredStackExcludesRuntimeFrames(t *testing.T) {
+	// Called through a closure, as `Recover` calls it from its deferred
+	// recover closure, which filteredStack skips.
+	stack := func() []byte { return filteredStack() }()
+
+	assert.True(t, strings.Contains(string(stack), "TestFilteredStackExcludesRuntimeFrames"))
+	assert.False(t, strings.Contains(string(stack), "runtime.Callers"))
+}
+
+func TestRecoverRecoversAndInvokesPanicHandler(t *testing.T) {
+	var handled bool
+	SetPanicHandler(func(w http.ResponseWriter, r *http.Request, rec interface{}, stack []byte) {
+		handled = true
+		assert.Equal(t, "boom", rec)
+		http.Error(w, "recovered", http.StatusInternalServerError)
+	})
+	defer SetPanicHandler(nil)
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic("boom")
+	})
+
+	rec := httptest.NewRecorder()
+	Recover(next).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	assert.True(t, handled)
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+}
+
+func TestRecoverSkipsPanicHandlerAfterResponseStarted(t *testing.T) {
+	var handled bool
+	SetPanicHandler(func(w http.ResponseWriter, r *http.Request, rec interface{}, stack []byte) {
+		handled = true
+	})
+	defer SetPanicHandler(nil)
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		panic("boom after write")
+	})
+
+	rec := httptest.NewRecorder()
+	Recover(next).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	assert.False(t, handled)
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestRecoverNoPanicPassesThrough(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	})
+
+	rec := httptest.NewRecorder()
+	Recover(next).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	assert.Equal(t, http.StatusTeapot, rec.Code)
+}
+
+func TestSetPanicHandlerNilRestoresDefault(t *testing.T) {
+	SetPanicHandler(func(w http.ResponseWriter, r *http.Request, rec interface{}, stack []byte) {
+		http.Error(w, "custom", http.StatusInternalServerError)
+	})
+	SetPanicHandler(nil)
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic("boom")
+	})
+
+	rec := httptest.NewRecorder()
+	Recover(next).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	assert.Equal(t, "500 Internal Server Error\n", rec.Body.String())
+}
@@ -0,0 +1,395 @@
+// Copyright (c) Jeevanandam M (https://github.com/jeevatkm)
+// go-aah/ahttp source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package ahttp
+
+import (
+	"bufio"
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// DefaultCompressMinSize is the response size, in bytes, below which
+// `CompressResponseWriter` doesn't bother compressing - the framing
+// overhead isn't worth it for tiny responses.
+const DefaultCompressMinSize = 1400
+
+// nonCompressibleTypes lists content types that are already compressed (or
+// gain nothing from it); `CompressResponseWriter` passes these through
+// untouched.
+var nonCompressibleTypes = map[string]bool{
+	"image/png":                    true,
+	"image/jpeg":                   true,
+	"image/gif":                    true,
+	"image/webp":                   true,
+	"video/mp4":                    true,
+	"video/webm":                   true,
+	"video/mpeg":                   true,
+	"audio/mpeg":                   true,
+	"application/zip":              true,
+	"application/gzip":             true,
+	"application/x-gzip":           true,
+	"application/x-rar-compressed": true,
+	"font/woff":                    true,
+	"font/woff2":                   true,
+}
+
+// Opts configures `NewCompressResponseWriter`.
+type Opts struct {
+	// Level is the `compress/gzip`/`compress/flate` compression level.
+	// `nil` means `gzip.DefaultCompression`. A pointer, rather than a
+	// plain `int`, is needed because `0` is itself a valid, meaningful
+	// level (`gzip.NoCompression`/`flate.NoCompression`) and must be
+	// distinguishable from "not configured."
+	Level *int
+
+	// MinSize is the minimum response size, in bytes, before compression
+	// kicks in. Zero means `DefaultCompressMinSize`.
+	MinSize int
+}
+
+// CompressResponseWriter wraps a `ResponseWriter`, transparently
+// compressing the response body with `gzip` or `deflate` per the
+// request's `Accept-Encoding` header. It buffers the first `MinSize`
+// bytes written and only starts compressing once that threshold is hit;
+// smaller responses are written through uncompressed. It is a no-op pass
+// through once the connection has been `Hijack`ed.
+type CompressResponseWriter struct {
+	ResponseWriter
+	req      *http.Request
+	opts     Opts
+	encoding string
+
+	buf         bytes.Buffer
+	cw          io.WriteCloser
+	bypass      bool
+	headersSent bool
+	hijacked    bool
+	rawBytes    int
+}
+
+var _ ResponseWriter = &CompressResponseWriter{}
+var _ http.Flusher = &CompressResponseWriter{}
+var _ http.Hijacker = &CompressResponseWriter{}
+
+//‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾
+// Global methods
+//___________________________________
+
+// NewCompressResponseWriter method negotiates `Accept-Encoding` against
+// `gzip`/`deflate` and, if the client supports one, wraps `w` with a
+// `CompressResponseWriter` that transparently compresses the response. If
+// the client sent no usable `Accept-Encoding`, `w` is returned unchanged.
+func NewCompressResponseWriter(w ResponseWriter, r *http.Request, opts Opts) ResponseWriter {
+	encoding := negotiateEncoding(r.Header.Get(HeaderAcceptEncoding))
+	if encoding == "" {
+		return w
+	}
+
+	if opts.MinSize <= 0 {
+		opts.MinSize = DefaultCompressMinSize
+	}
+
+	return &CompressResponseWriter{ResponseWriter: w, req: r, opts: opts, encoding: encoding}
+}
+
+// negotiateEncoding picks `gzip` over `deflate` when the client's
+// `Accept-Encoding` header offers both; returns "" when neither is
+// acceptable.
+func negotiateEncoding(acceptEncoding string) string {
+	accepted := strings.ToLower(acceptEncoding)
+	if strings.Contains(accepted, "gzip") {
+		return "gzip"
+	}
+	if strings.Contains(accepted, "deflate") {
+		return "deflate"
+	}
+	return ""
+}
+
+//‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾
+// CompressResponseWriter methods
+//___________________________________
+
+// Write method buffers bytes until `Opts.MinSize` is reached, decides
+// whether to compress, then routes every subsequent byte accordingly.
+// `BytesWritten` continues to report the uncompressed count.
+func (cw *CompressResponseWriter) Write(b []byte) (int, error) {
+	if cw.hijacked {
+		return cw.ResponseWriter.Write(b)
+	}
+
+	cw.rawBytes += len(b)
+
+	if cw.bypass {
+		return cw.passThroughWrite(b)
+	}
+
+	if cw.cw != nil {
+		return cw.cw.Write(b)
+	}
+
+	cw.buf.Write(b)
+	if cw.buf.Len() >= cw.opts.MinSize {
+		if err := cw.decide(); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(b), nil
+}
+
+// BytesWritten method returns the uncompressed byte count written so far,
+// so logging/metrics match what the handler believes it wrote.
+func (cw *CompressResponseWriter) BytesWritten() int {
+	return cw.rawBytes
+}
+
+// Flush method flushes any buffered/compressed bytes to the underlying
+// `ResponseWriter` and, if compatible, the underlying connection.
+func (cw *CompressResponseWriter) Flush() {
+	if cw.hijacked {
+		return
+	}
+
+	if !cw.bypass && cw.cw == nil {
+		_ = cw.decide()
+	}
+
+	// cw.cw is always one of the pooled wrapper types, never a bare
+	// `*gzip.Writer`/`*flate.Writer` - match the promoted `Flush` method
+	// via an interface instead of a concrete type assertion.
+	if f, ok := cw.cw.(interface{ Flush() error }); ok {
+		_ = f.Flush()
+	}
+
+	if f, ok := cw.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Hijack method calls the underlying `Hijack` and, on success, disables
+// compression for the remainder of the connection's lifetime.
+func (cw *CompressResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	h, ok := cw.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, errors.New("ahttp: underlying ResponseWriter is not a http.Hijacker")
+	}
+
+	conn, rw, err := h.Hijack()
+	if err == nil {
+		cw.hijacked = true
+	}
+	return conn, rw, err
+}
+
+// Close method flushes any buffered bytes that never reached `MinSize`
+// and closes the compressor, if one was started. The aah request
+// pipeline calls this once the handler has finished writing the
+// response; it is a no-op on an already-hijacked connection.
+func (cw *CompressResponseWriter) Close() error {
+	if cw.hijacked {
+		return nil
+	}
+
+	if cw.cw == nil && !cw.bypass {
+		// Response never reached MinSize - not worth compressing.
+		cw.bypass = true
+		if _, err := cw.passThroughWrite(nil); err != nil {
+			return err
+		}
+	}
+
+	if cw.cw != nil {
+		return cw.cw.Close()
+	}
+	return nil
+}
+
+// decide inspects the buffered prefix, picks whether to compress based on
+// content type, and either starts the compressor or falls back to a
+// pass-through write of everything buffered so far.
+func (cw *CompressResponseWriter) decide() error {
+	if isCompressible(cw.contentType()) {
+		return cw.startCompression()
+	}
+
+	cw.bypass = true
+	_, err := cw.passThroughWrite(nil)
+	return err
+}
+
+// contentType returns the explicitly-set `Content-Type` header, or sniffs
+// one from the buffered bytes when the handler hasn't set one.
+func (cw *CompressResponseWriter) contentType() string {
+	if ct := cw.Header().Get(HeaderContentType); ct != "" {
+		return ct
+	}
+	return http.DetectContentType(cw.buf.Bytes())
+}
+
+func isCompressible(contentType string) bool {
+	base := contentType
+	if idx := strings.IndexByte(base, ';'); idx >= 0 {
+		base = base[:idx]
+	}
+	return !nonCompressibleTypes[strings.TrimSpace(strings.ToLower(base))]
+}
+
+// startCompression commits to compressing the response: it sets
+// `Content-Encoding`/`Vary`, strips `Content-Length` (the final size
+// isn't known ahead of time), flushes headers, and drains the buffered
+// prefix into a freshly-acquired compressor.
+func (cw *CompressResponseWriter) startCompression() error {
+	cw.Header().Set(HeaderContentEncoding, cw.encoding)
+	cw.Header().Add(HeaderVary, HeaderAcceptEncoding)
+	cw.Header().Del(HeaderContentLength)
+	cw.sendHeaders()
+
+	cw.cw = acquireCompressor(cw.encoding, resolveLevel(cw.opts.Level), cw.ResponseWriter)
+
+	if cw.buf.Len() == 0 {
+		return nil
+	}
+	_, err := cw.cw.Write(cw.buf.Bytes())
+	cw.buf.Reset()
+	return err
+}
+
+// passThroughWrite sends headers (without compression) and writes b plus
+// anything buffered so far directly to the underlying ResponseWriter.
+func (cw *CompressResponseWriter) passThroughWrite(b []byte) (int, error) {
+	cw.sendHeaders()
+
+	if cw.buf.Len() > 0 {
+		if _, err := cw.ResponseWriter.Write(cw.buf.Bytes()); err != nil {
+			return 0, err
+		}
+		cw.buf.Reset()
+	}
+
+	if len(b) == 0 {
+		return 0, nil
+	}
+	return cw.ResponseWriter.Write(b)
+}
+
+func (cw *CompressResponseWriter) sendHeaders() {
+	if cw.headersSent {
+		return
+	}
+	cw.headersSent = true
+	if cw.Status() == 0 {
+		cw.WriteHeader(http.StatusOK)
+	}
+}
+
+//‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾
+// Compressor pooling
+//___________________________________
+
+var (
+	gzipPoolMu sync.Mutex
+	gzipPools  = map[int]*sync.Pool{}
+
+	flatePoolMu sync.Mutex
+	flatePools  = map[int]*sync.Pool{}
+)
+
+// acquireCompressor returns a pooled `gzip.Writer`/`flate.Writer` for the
+// given encoding and already-resolved level (see `resolveLevel`), reset
+// to write into w. The returned writer's `Close` puts the underlying
+// `gzip.Writer`/`flate.Writer` back into its pool.
+func acquireCompressor(encoding string, level int, w io.Writer) io.WriteCloser {
+	if encoding == "deflate" {
+		pool := flatePool(level)
+		fw := pool.Get().(*flate.Writer)
+		fw.Reset(w)
+		return &pooledFlateWriter{Writer: fw, level: level}
+	}
+
+	pool := gzipPool(level)
+	gw := pool.Get().(*gzip.Writer)
+	gw.Reset(w)
+	return &pooledGzipWriter{Writer: gw, level: level}
+}
+
+func gzipPool(level int) *sync.Pool {
+	gzipPoolMu.Lock()
+	defer gzipPoolMu.Unlock()
+	if p, ok := gzipPools[level]; ok {
+		return p
+	}
+	p := &sync.Pool{New: func() interface{} {
+		gw, err := gzip.NewWriterLevel(ioutil.Discard, level)
+		if err != nil {
+			gw = gzip.NewWriter(ioutil.Discard)
+		}
+		return gw
+	}}
+	gzipPools[level] = p
+	return p
+}
+
+func flatePool(level int) *sync.Pool {
+	flatePoolMu.Lock()
+	defer flatePoolMu.Unlock()
+	if p, ok := flatePools[level]; ok {
+		return p
+	}
+	p := &sync.Pool{New: func() interface{} {
+		fw, err := flate.NewWriter(ioutil.Discard, level)
+		if err != nil {
+			fw, _ = flate.NewWriter(ioutil.Discard, flate.DefaultCompression)
+		}
+		return fw
+	}}
+	flatePools[level] = p
+	return p
+}
+
+// resolveLevel returns `gzip.DefaultCompression` for an unconfigured
+// (`nil`) level, otherwise the configured value verbatim - including `0`
+// (`gzip.NoCompression`/`flate.NoCompression`), which is a deliberate
+// choice, not an absent one.
+func resolveLevel(level *int) int {
+	if level == nil {
+		return gzip.DefaultCompression
+	}
+	return *level
+}
+
+// pooledGzipWriter returns its `*gzip.Writer` to the level-keyed pool on
+// `Close`.
+type pooledGzipWriter struct {
+	*gzip.Writer
+	level int
+}
+
+func (p *pooledGzipWriter) Close() error {
+	err := p.Writer.Close()
+	gzipPool(p.level).Put(p.Writer)
+	return err
+}
+
+// pooledFlateWriter returns its `*flate.Writer` to the level-keyed pool on
+// `Close`.
+type pooledFlateWriter struct {
+	*flate.Writer
+	level int
+}
+
+func (p *pooledFlateWriter) Close() error {
+	err := p.Writer.Close()
+	flatePool(p.level).Put(p.Writer)
+	return err
+}
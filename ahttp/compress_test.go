@@ -0,0 +1,104 @@
+// Copyright (c) Jeevanandam M (https://github.com/jeevatkm)
+// go-aah/ahttp source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package ahttp
+
+import (
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"aahframework.org/test.v0/assert"
+)
+
+func newGzipRequest() *http.Request {
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.Header.Set(HeaderAcceptEncoding, "gzip, deflate")
+	return r
+}
+
+func TestCompressResponseWriterNoAcceptEncoding(t *testing.T) {
+	rec := httptest.NewRecorder()
+	w := WrapResponseWriter(rec)
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+
+	cw := NewCompressResponseWriter(w, r, Opts{MinSize: 1})
+	assert.Equal(t, w, cw)
+}
+
+func TestCompressResponseWriterBelowMinSize(t *testing.T) {
+	rec := httptest.NewRecorder()
+	w := WrapResponseWriter(rec)
+	r := newGzipRequest()
+
+	cw := NewCompressResponseWriter(w, r, Opts{MinSize: 1024})
+	_, err := cw.Write([]byte("tiny body"))
+	assert.Nil(t, err)
+	assert.Nil(t, cw.(*CompressResponseWriter).Close())
+
+	assert.Equal(t, "", rec.Header().Get(HeaderContentEncoding))
+	assert.Equal(t, "tiny body", rec.Body.String())
+	assert.Equal(t, len("tiny body"), cw.BytesWritten())
+}
+
+func TestCompressResponseWriterAboveMinSize(t *testing.T) {
+	rec := httptest.NewRecorder()
+	w := WrapResponseWriter(rec)
+	r := newGzipRequest()
+
+	body := strings.Repeat("a", 4096)
+	cw := NewCompressResponseWriter(w, r, Opts{MinSize: 16})
+	_, err := cw.Write([]byte(body))
+	assert.Nil(t, err)
+	assert.Nil(t, cw.(*CompressResponseWriter).Close())
+
+	assert.Equal(t, "gzip", rec.Header().Get(HeaderContentEncoding))
+	assert.Equal(t, len(body), cw.BytesWritten())
+
+	gr, err := gzip.NewReader(rec.Body)
+	assert.Nil(t, err)
+	out, err := ioutil.ReadAll(gr)
+	assert.Nil(t, err)
+	assert.Equal(t, body, string(out))
+}
+
+func TestCompressResponseWriterSkipsNonCompressibleType(t *testing.T) {
+	rec := httptest.NewRecorder()
+	w := WrapResponseWriter(rec)
+	r := newGzipRequest()
+
+	body := strings.Repeat("x", 4096)
+	cw := NewCompressResponseWriter(w, r, Opts{MinSize: 16})
+	cw.Header().Set(HeaderContentType, "image/png")
+	_, err := cw.Write([]byte(body))
+	assert.Nil(t, err)
+	assert.Nil(t, cw.(*CompressResponseWriter).Close())
+
+	assert.Equal(t, "", rec.Header().Get(HeaderContentEncoding))
+	assert.Equal(t, body, rec.Body.String())
+}
+
+func TestCompressResponseWriterExplicitNoCompressionLevel(t *testing.T) {
+	rec := httptest.NewRecorder()
+	w := WrapResponseWriter(rec)
+	r := newGzipRequest()
+
+	level := gzip.NoCompression
+	body := strings.Repeat("b", 4096)
+	cw := NewCompressResponseWriter(w, r, Opts{MinSize: 16, Level: &level})
+	_, err := cw.Write([]byte(body))
+	assert.Nil(t, err)
+	assert.Nil(t, cw.(*CompressResponseWriter).Close())
+
+	assert.Equal(t, "gzip", rec.Header().Get(HeaderContentEncoding))
+
+	gr, err := gzip.NewReader(rec.Body)
+	assert.Nil(t, err)
+	out, err := ioutil.ReadAll(gr)
+	assert.Nil(t, err)
+	assert.Equal(t, body, string(out))
+}
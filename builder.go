@@ -0,0 +1,126 @@
+// Copyright (c) Jeevanandam M (https://github.com/jeevatkm)
+// go-aah/log source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package log
+
+// EntryBuilder accumulates structured fields for a single log entry before
+// it is emitted. It is cheap to create - it only carries a reference to
+// the parent `Logger` and the fields accumulated so far - and is returned
+// by `Logger.WithField`, `WithFields` and `WithError`.
+type EntryBuilder struct {
+	logger *Logger
+	fields Fields
+	err    error
+}
+
+// WithField method starts a structured log entry carrying the given
+// key-value pair. Chain additional `WithField`/`WithFields`/`WithError`
+// calls, then call a terminal method (`Info`, `Error`, etc.) to emit it.
+// For e.g.: `log.WithField("user_id", 42).Info("login")`.
+func (l *Logger) WithField(key string, value interface{}) *EntryBuilder {
+	return &EntryBuilder{logger: l, fields: Fields{key: value}}
+}
+
+// WithFields method starts a structured log entry carrying the given
+// fields. For e.g.: `log.WithFields(log.Fields{"user_id": 42}).Info("login")`.
+func (l *Logger) WithFields(fields Fields) *EntryBuilder {
+	b := &EntryBuilder{logger: l, fields: make(Fields, len(fields))}
+	for k, v := range fields {
+		b.fields[k] = v
+	}
+	return b
+}
+
+// WithError method starts a structured log entry carrying the given error,
+// typically logged out via the terminal `Error`/`Errorf` method.
+// For e.g.: `log.WithError(err).Error("unable to process request")`.
+func (l *Logger) WithError(err error) *EntryBuilder {
+	return &EntryBuilder{logger: l, err: err}
+}
+
+// WithField method adds the given key-value pair to the entry being built.
+func (b *EntryBuilder) WithField(key string, value interface{}) *EntryBuilder {
+	if b.fields == nil {
+		b.fields = make(Fields)
+	}
+	b.fields[key] = value
+	return b
+}
+
+// WithFields method merges the given fields into the entry being built.
+func (b *EntryBuilder) WithFields(fields Fields) *EntryBuilder {
+	if b.fields == nil {
+		b.fields = make(Fields, len(fields))
+	}
+	for k, v := range fields {
+		b.fields[k] = v
+	}
+	return b
+}
+
+// WithError method attaches the given error to the entry being built.
+func (b *EntryBuilder) WithError(err error) *EntryBuilder {
+	b.err = err
+	return b
+}
+
+// Error logs the accumulated entry as `ERROR`. Arguments handled in the
+// mananer of `fmt.Print`.
+func (b *EntryBuilder) Error(v ...interface{}) {
+	b.logger.output(LevelError, 3, nil, b.fields, b.err, v...)
+}
+
+// Errorf logs the accumulated entry as `ERROR`. Arguments handled in the
+// mananer of `fmt.Printf`.
+func (b *EntryBuilder) Errorf(format string, v ...interface{}) {
+	b.logger.output(LevelError, 3, &format, b.fields, b.err, v...)
+}
+
+// Warn logs the accumulated entry as `WARN`. Arguments handled in the
+// mananer of `fmt.Print`.
+func (b *EntryBuilder) Warn(v ...interface{}) {
+	b.logger.output(LevelWarn, 3, nil, b.fields, b.err, v...)
+}
+
+// Warnf logs the accumulated entry as `WARN`. Arguments handled in the
+// mananer of `fmt.Printf`.
+func (b *EntryBuilder) Warnf(format string, v ...interface{}) {
+	b.logger.output(LevelWarn, 3, &format, b.fields, b.err, v...)
+}
+
+// Info logs the accumulated entry as `INFO`. Arguments handled in the
+// mananer of `fmt.Print`.
+func (b *EntryBuilder) Info(v ...interface{}) {
+	b.logger.output(LevelInfo, 3, nil, b.fields, b.err, v...)
+}
+
+// Infof logs the accumulated entry as `INFO`. Arguments handled in the
+// mananer of `fmt.Printf`.
+func (b *EntryBuilder) Infof(format string, v ...interface{}) {
+	b.logger.output(LevelInfo, 3, &format, b.fields, b.err, v...)
+}
+
+// Debug logs the accumulated entry as `DEBUG`. Arguments handled in the
+// mananer of `fmt.Print`.
+func (b *EntryBuilder) Debug(v ...interface{}) {
+	b.logger.output(LevelDebug, 3, nil, b.fields, b.err, v...)
+}
+
+// Debugf logs the accumulated entry as `DEBUG`. Arguments handled in the
+// mananer of `fmt.Printf`.
+func (b *EntryBuilder) Debugf(format string, v ...interface{}) {
+	b.logger.output(LevelDebug, 3, &format, b.fields, b.err, v...)
+}
+
+// Trace logs the accumulated entry as `TRACE`. Arguments handled in the
+// mananer of `fmt.Print`.
+func (b *EntryBuilder) Trace(v ...interface{}) {
+	b.logger.output(LevelTrace, 3, nil, b.fields, b.err, v...)
+}
+
+// Tracef logs the accumulated entry as `TRACE`. Arguments handled in the
+// mananer of `fmt.Printf`.
+func (b *EntryBuilder) Tracef(format string, v ...interface{}) {
+	b.logger.output(LevelTrace, 3, &format, b.fields, b.err, v...)
+}
@@ -0,0 +1,74 @@
+// Copyright (c) Jeevanandam M (https://github.com/jeevatkm)
+// go-aah/log source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package log
+
+import (
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+)
+
+type (
+	// Fields is a typed map of arbitrary key-value pairs attached to a log
+	// `Entry` via `Logger.WithField{s}`. Field values are passed through
+	// as-is to the configured `Receiver`.
+	Fields map[string]interface{}
+
+	// Entry represents a single log record handed to a `Receiver.Log`.
+	// Entries are pooled and reset after every call, so a `Receiver` must
+	// not retain a reference to one beyond the scope of `Log`.
+	Entry struct {
+		Level   level
+		Time    time.Time
+		Message string
+		Fields  Fields
+		Error   error
+		File    string
+		Line    int
+	}
+)
+
+var entryPool = &sync.Pool{New: func() interface{} { return &Entry{} }}
+
+// acquireEntry method gets entry from pool.
+func acquireEntry() *Entry {
+	return entryPool.Get().(*Entry)
+}
+
+// releaseEntry method resets entry and puts it back into the pool. Every
+// field is cleared, not just `Fields`/`Error` - `entryPool` is shared by
+// every `*Logger` in the process, so a stale `File`/`Line` left behind by
+// a caller-info-enabled logger would otherwise leak through untouched on
+// an entry recycled into a logger whose receiver has `IsCallerInfo() ==
+// false`, which never repopulates them.
+func releaseEntry(e *Entry) {
+	e.Level = 0
+	e.Time = time.Time{}
+	e.Message = ""
+	e.Fields = nil
+	e.Error = nil
+	e.File = ""
+	e.Line = 0
+	entryPool.Put(e)
+}
+
+// fetchCallerInfo method returns the caller's file (trimmed to its
+// immediate parent directory for readability) and line number at the
+// given call depth.
+func fetchCallerInfo(calldepth int) (file string, line int) {
+	_, file, line, ok := runtime.Caller(calldepth)
+	if !ok {
+		return "???", 0
+	}
+
+	if idx := strings.LastIndex(file, "/"); idx >= 0 {
+		if idx2 := strings.LastIndex(file[:idx], "/"); idx2 >= 0 {
+			file = file[idx2+1:]
+		}
+	}
+
+	return file, line
+}
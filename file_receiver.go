@@ -0,0 +1,127 @@
+// Copyright (c) Jeevanandam M (https://github.com/jeevatkm)
+// go-aah/log source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package log
+
+import (
+	"io"
+	"os"
+	"sync"
+
+	"aahframework.org/config.v0"
+)
+
+// FileReceiver writes `Entry` records to a file on disk, formatted per
+// `SetPattern` (see `DefaultPattern`/`formatEntry`). It supports external
+// rotation: when `log.file.rotate.external` is `true`, calling `Reopen`
+// (or sending the process `SIGHUP`) closes the currently open file and
+// reopens the configured path, so a `logrotate`-style tool can `mv` the
+// file out from under it and signal the process. Size/daily/line-count
+// rotation are not implemented by this receiver.
+type FileReceiver struct {
+	file       string
+	pattern    string
+	callerInfo bool
+	writer     *reopenableWriter
+}
+
+// Init method initializes file receiver instance.
+func (f *FileReceiver) Init(cfg *config.Config) error {
+	f.file = cfg.StringDefault("log.file", "aah.log")
+	f.callerInfo = cfg.BoolDefault("log.caller_info", true)
+
+	w, err := newReopenableWriter(f.file)
+	if err != nil {
+		return err
+	}
+	f.writer = w
+
+	if cfg.BoolDefault("log.file.rotate.external", false) {
+		registerReopenSignal(f)
+	}
+
+	return nil
+}
+
+// SetPattern method sets the log entry format pattern for this receiver.
+func (f *FileReceiver) SetPattern(pattern string) error {
+	f.pattern = pattern
+	return nil
+}
+
+// IsCallerInfo method returns true if the receiver is configured to
+// capture caller info otherwise false.
+func (f *FileReceiver) IsCallerInfo() bool {
+	return f.callerInfo
+}
+
+// IsPatternDependent method returns true; `FileReceiver` formats every
+// `Entry` according to `SetPattern`. See `Receiver.IsPatternDependent`.
+func (f *FileReceiver) IsPatternDependent() bool {
+	return true
+}
+
+// Writer method returns the receiver's writer. The returned `io.Writer` is
+// a stable proxy that survives `Reopen` - callers (e.g. `Logger.ToGoLogger`)
+// may hold onto it across reopens instead of the underlying `*os.File`.
+func (f *FileReceiver) Writer() io.Writer {
+	return f.writer
+}
+
+// Log method writes the given `Entry` to the file, formatted per
+// `SetPattern` (or `DefaultPattern` if `SetPattern` was never called).
+func (f *FileReceiver) Log(e *Entry) {
+	pattern := f.pattern
+	if pattern == "" {
+		pattern = DefaultPattern
+	}
+	_, _ = f.writer.Write(formatEntry(pattern, e))
+}
+
+// Reopen method closes the currently open log file and reopens the
+// configured path. Safe to call concurrently with `Log`.
+func (f *FileReceiver) Reopen() error {
+	return f.writer.reopen()
+}
+
+// reopenableWriter is an `io.Writer` over an `*os.File` that can be
+// atomically swapped out from under concurrent writers. The hot `Write`
+// path only takes a read lock; `reopen` takes the write lock to swap and
+// close the old file.
+type reopenableWriter struct {
+	mu   sync.RWMutex
+	path string
+	file *os.File
+}
+
+func newReopenableWriter(path string) (*reopenableWriter, error) {
+	w := &reopenableWriter{path: path}
+	if err := w.reopen(); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+func (w *reopenableWriter) Write(p []byte) (int, error) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	return w.file.Write(p)
+}
+
+func (w *reopenableWriter) reopen() error {
+	nf, err := os.OpenFile(w.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, filePermission)
+	if err != nil {
+		return err
+	}
+
+	w.mu.Lock()
+	old := w.file
+	w.file = nf
+	w.mu.Unlock()
+
+	if old != nil {
+		return old.Close()
+	}
+	return nil
+}
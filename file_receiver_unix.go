@@ -0,0 +1,27 @@
+// Copyright (c) Jeevanandam M (https://github.com/jeevatkm)
+// go-aah/log source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+// +build !windows
+
+package log
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+// registerReopenSignal starts a goroutine that calls `Reopen` every time
+// the process receives `SIGHUP`, so external log-rotation tools can
+// `mv`/`rename` the file and signal aah rather than aah owning rotation.
+func registerReopenSignal(f *FileReceiver) {
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGHUP)
+
+	go func() {
+		for range sigCh {
+			_ = f.Reopen()
+		}
+	}()
+}
@@ -0,0 +1,12 @@
+// Copyright (c) Jeevanandam M (https://github.com/jeevatkm)
+// go-aah/log source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package log
+
+// registerReopenSignal is a no-op on Windows; `SIGHUP` doesn't exist there.
+// Applications can still call `FileReceiver.Reopen` directly, e.g. from a
+// scheduled task.
+func registerReopenSignal(f *FileReceiver) {}
@@ -0,0 +1,124 @@
+// Copyright (c) Jeevanandam M (https://github.com/jeevatkm)
+// go-aah/log source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package log
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"aahframework.org/config.v0"
+)
+
+// jsonReservedKeys are the top-level field names `JSONReceiver` writes
+// itself; a caller-supplied field with one of these names is namespaced
+// under `fields` instead of overwriting it. `fields` itself is reserved
+// too, since that's the namespace key used for the collision case.
+var jsonReservedKeys = map[string]bool{
+	"time": true, "level": true, "message": true, "caller": true, "error": true, "fields": true,
+}
+
+type jsonEntry struct {
+	Time    string `json:"time"`
+	Level   string `json:"level"`
+	Message string `json:"message"`
+	Caller  string `json:"caller,omitempty"`
+	Error   string `json:"error,omitempty"`
+}
+
+// JSONReceiver writes each `Entry` as a single JSON object per line -
+// time (RFC3339Nano), level, message, caller `file:line` and all `Fields`
+// flattened at the top level. It has a fixed, self-describing schema and
+// so ignores `log.pattern`; see `IsPatternDependent`.
+type JSONReceiver struct {
+	out        io.Writer
+	callerInfo bool
+}
+
+// Init method initializes JSON receiver instance.
+func (j *JSONReceiver) Init(cfg *config.Config) error {
+	j.out = os.Stderr
+	j.callerInfo = cfg.BoolDefault("log.caller_info", true)
+	return nil
+}
+
+// SetPattern method is a no-op for `JSONReceiver` - it always emits the
+// fixed JSON schema described on the type and never honors `log.pattern`.
+func (j *JSONReceiver) SetPattern(pattern string) error {
+	return nil
+}
+
+// IsCallerInfo method returns true if the receiver is configured to
+// capture caller info otherwise false.
+func (j *JSONReceiver) IsCallerInfo() bool {
+	return j.callerInfo
+}
+
+// IsPatternDependent method returns false; `JSONReceiver` never honors
+// `log.pattern`. See `Receiver.IsPatternDependent`.
+func (j *JSONReceiver) IsPatternDependent() bool {
+	return false
+}
+
+// Writer method returns the underlying writer of JSON receiver.
+func (j *JSONReceiver) Writer() io.Writer {
+	return j.out
+}
+
+// Log method writes the given `Entry` as a single JSON line.
+func (j *JSONReceiver) Log(e *Entry) {
+	rec := jsonEntry{
+		Time:    e.Time.Format(time.RFC3339Nano),
+		Level:   levelToLevelName[e.Level],
+		Message: e.Message,
+	}
+	if e.File != "" {
+		rec.Caller = fmt.Sprintf("%s:%d", e.File, e.Line)
+	}
+	if e.Error != nil {
+		rec.Error = e.Error.Error()
+	}
+
+	b, err := marshalJSONEntry(rec, e.Fields)
+	if err != nil {
+		return
+	}
+
+	_, _ = j.out.Write(append(b, '\n'))
+}
+
+// marshalJSONEntry flattens the given fields onto the fixed entry record,
+// namespacing any field whose key collides with a reserved top-level name
+// under `fields` rather than silently overwriting it.
+func marshalJSONEntry(rec jsonEntry, fields Fields) ([]byte, error) {
+	b, err := json.Marshal(rec)
+	if err != nil {
+		return nil, err
+	}
+	if len(fields) == 0 {
+		return b, nil
+	}
+
+	flat := make(map[string]interface{})
+	if err := json.Unmarshal(b, &flat); err != nil {
+		return nil, err
+	}
+
+	namespaced := make(Fields)
+	for k, v := range fields {
+		if jsonReservedKeys[k] {
+			namespaced[k] = v
+		} else {
+			flat[k] = v
+		}
+	}
+	if len(namespaced) > 0 {
+		flat["fields"] = namespaced
+	}
+
+	return json.Marshal(flat)
+}
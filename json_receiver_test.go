@@ -0,0 +1,73 @@
+// Copyright (c) Jeevanandam M (https://github.com/jeevatkm)
+// go-aah/log source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package log
+
+import (
+	"encoding/json"
+	"testing"
+
+	"aahframework.org/test.v0/assert"
+)
+
+func TestMarshalJSONEntryNoFields(t *testing.T) {
+	rec := jsonEntry{Time: "2016-07-03T19:22:11Z", Level: "INFO", Message: "hello"}
+
+	b, err := marshalJSONEntry(rec, nil)
+	assert.Nil(t, err)
+
+	var out map[string]interface{}
+	assert.Nil(t, json.Unmarshal(b, &out))
+	assert.Equal(t, "hello", out["message"])
+	assert.Nil(t, out["fields"])
+}
+
+func TestMarshalJSONEntryPlainFields(t *testing.T) {
+	rec := jsonEntry{Time: "2016-07-03T19:22:11Z", Level: "INFO", Message: "login"}
+
+	b, err := marshalJSONEntry(rec, Fields{"user_id": float64(42)})
+	assert.Nil(t, err)
+
+	var out map[string]interface{}
+	assert.Nil(t, json.Unmarshal(b, &out))
+	assert.Equal(t, float64(42), out["user_id"])
+	assert.Nil(t, out["fields"])
+}
+
+func TestMarshalJSONEntryReservedKeyCollision(t *testing.T) {
+	rec := jsonEntry{Time: "2016-07-03T19:22:11Z", Level: "INFO", Message: "login"}
+
+	b, err := marshalJSONEntry(rec, Fields{"message": "caller supplied"})
+	assert.Nil(t, err)
+
+	var out map[string]interface{}
+	assert.Nil(t, json.Unmarshal(b, &out))
+	assert.Equal(t, "login", out["message"])
+
+	fields, ok := out["fields"].(map[string]interface{})
+	assert.True(t, ok)
+	assert.Equal(t, "caller supplied", fields["message"])
+}
+
+// TestMarshalJSONEntryFieldsKeyCollision guards against the case where a
+// caller passes a field literally named "fields" alongside another
+// reserved-name collision: "fields" must itself be namespaced, not used
+// as the (overwritten) namespace key.
+func TestMarshalJSONEntryFieldsKeyCollision(t *testing.T) {
+	rec := jsonEntry{Time: "2016-07-03T19:22:11Z", Level: "INFO", Message: "login"}
+
+	b, err := marshalJSONEntry(rec, Fields{
+		"message": "caller supplied",
+		"fields":  "should not vanish",
+	})
+	assert.Nil(t, err)
+
+	var out map[string]interface{}
+	assert.Nil(t, json.Unmarshal(b, &out))
+
+	fields, ok := out["fields"].(map[string]interface{})
+	assert.True(t, ok)
+	assert.Equal(t, "caller supplied", fields["message"])
+	assert.Equal(t, "should not vanish", fields["fields"])
+}
@@ -0,0 +1,30 @@
+// Copyright (c) Jeevanandam M (https://github.com/jeevatkm)
+// go-aah/log source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package log
+
+import "strings"
+
+// levelToLevelName maps a `level` to its textual representation, used by
+// `Logger.Level` and by receivers that print/encode the level name.
+var levelToLevelName = map[level]string{
+	levelFatal: "FATAL",
+	levelPanic: "PANIC",
+	LevelError: "ERROR",
+	LevelWarn:  "WARN",
+	LevelInfo:  "INFO",
+	LevelDebug: "DEBUG",
+	LevelTrace: "TRACE",
+}
+
+// levelByName method returns the `level` for the given name, case-insensitive.
+// Returns `LevelUnknown` if the name doesn't match any known level.
+func levelByName(name string) level {
+	for l, n := range levelToLevelName {
+		if strings.EqualFold(n, name) {
+			return l
+		}
+	}
+	return LevelUnknown
+}
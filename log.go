@@ -69,6 +69,13 @@ type (
 		Init(cfg *config.Config) error
 		SetPattern(pattern string) error
 		IsCallerInfo() bool
+
+		// IsPatternDependent method returns true if the receiver formats
+		// `Entry` according to `SetPattern` (e.g. Console, File) or false
+		// if it has its own fixed wire format and ignores `log.pattern`
+		// altogether (e.g. JSON).
+		IsPatternDependent() bool
+
 		Writer() io.Writer
 		Log(e *Entry)
 	}
@@ -77,10 +84,11 @@ type (
 	// format flags. Logger can be used simultaneously from multiple goroutines;
 	// it guarantees to serialize access to the Receivers.
 	Logger struct {
-		cfg      *config.Config
-		m        *sync.Mutex
-		level    level
-		receiver Receiver
+		cfg       *config.Config
+		m         *sync.Mutex
+		level     level
+		configLvl level // level originally supplied via `log.level` config, used to reset at runtime
+		receiver  Receiver
 	}
 )
 
@@ -111,6 +119,7 @@ func New(cfg *config.Config) (*Logger, error) {
 	if err := logger.SetLevel(cfg.StringDefault("log.level", "DEBUG")); err != nil {
 		return nil, err
 	}
+	logger.configLvl = logger.level
 
 	return logger, nil
 }
@@ -124,6 +133,14 @@ func (l *Logger) Level() string {
 	return levelToLevelName[l.level]
 }
 
+// ConfiguredLevel method returns the logging level originally supplied via
+// `log.level` config at `New`, regardless of any runtime `SetLevel` calls
+// made since. Used by the admin loggers endpoint to reset a logger back
+// to its configured default.
+func (l *Logger) ConfiguredLevel() string {
+	return levelToLevelName[l.configLvl]
+}
+
 // SetLevel method sets the given logging level for the logger.
 // For e.g.: INFO, WARN, DEBUG, etc. Case-insensitive.
 func (l *Logger) SetLevel(level string) error {
@@ -171,52 +188,52 @@ func (l *Logger) ToGoLogger() *slog.Logger {
 
 // Error logs message as `ERROR`. Arguments handled in the mananer of `fmt.Print`.
 func (l *Logger) Error(v ...interface{}) {
-	l.output(LevelError, 3, nil, v...)
+	l.output(LevelError, 3, nil, nil, nil, v...)
 }
 
 // Errorf logs message as `ERROR`. Arguments handled in the mananer of `fmt.Printf`.
 func (l *Logger) Errorf(format string, v ...interface{}) {
-	l.output(LevelError, 3, &format, v...)
+	l.output(LevelError, 3, &format, nil, nil, v...)
 }
 
 // Warn logs message as `WARN`. Arguments handled in the mananer of `fmt.Print`.
 func (l *Logger) Warn(v ...interface{}) {
-	l.output(LevelWarn, 3, nil, v...)
+	l.output(LevelWarn, 3, nil, nil, nil, v...)
 }
 
 // Warnf logs message as `WARN`. Arguments handled in the mananer of `fmt.Printf`.
 func (l *Logger) Warnf(format string, v ...interface{}) {
-	l.output(LevelWarn, 3, &format, v...)
+	l.output(LevelWarn, 3, &format, nil, nil, v...)
 }
 
 // Info logs message as `INFO`. Arguments handled in the mananer of `fmt.Print`.
 func (l *Logger) Info(v ...interface{}) {
-	l.output(LevelInfo, 3, nil, v...)
+	l.output(LevelInfo, 3, nil, nil, nil, v...)
 }
 
 // Infof logs message as `INFO`. Arguments handled in the mananer of `fmt.Printf`.
 func (l *Logger) Infof(format string, v ...interface{}) {
-	l.output(LevelInfo, 3, &format, v...)
+	l.output(LevelInfo, 3, &format, nil, nil, v...)
 }
 
 // Debug logs message as `DEBUG`. Arguments handled in the mananer of `fmt.Print`.
 func (l *Logger) Debug(v ...interface{}) {
-	l.output(LevelDebug, 3, nil, v...)
+	l.output(LevelDebug, 3, nil, nil, nil, v...)
 }
 
 // Debugf logs message as `DEBUG`. Arguments handled in the mananer of `fmt.Printf`.
 func (l *Logger) Debugf(format string, v ...interface{}) {
-	l.output(LevelDebug, 3, &format, v...)
+	l.output(LevelDebug, 3, &format, nil, nil, v...)
 }
 
 // Trace logs message as `TRACE`. Arguments handled in the mananer of `fmt.Print`.
 func (l *Logger) Trace(v ...interface{}) {
-	l.output(LevelTrace, 3, nil, v...)
+	l.output(LevelTrace, 3, nil, nil, nil, v...)
 }
 
 // Tracef logs message as `TRACE`. Arguments handled in the mananer of `fmt.Printf`.
 func (l *Logger) Tracef(format string, v ...interface{}) {
-	l.output(LevelTrace, 3, &format, v...)
+	l.output(LevelTrace, 3, &format, nil, nil, v...)
 }
 
 //‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾‾
@@ -226,52 +243,52 @@ func (l *Logger) Tracef(format string, v ...interface{}) {
 
 // Print logs message as `INFO`. Arguments handled in the mananer of `fmt.Print`.
 func (l *Logger) Print(v ...interface{}) {
-	l.output(LevelInfo, 3, nil, v...)
+	l.output(LevelInfo, 3, nil, nil, nil, v...)
 }
 
 // Printf logs message as `INFO`. Arguments handled in the mananer of `fmt.Printf`.
 func (l *Logger) Printf(format string, v ...interface{}) {
-	l.output(LevelInfo, 3, &format, v...)
+	l.output(LevelInfo, 3, &format, nil, nil, v...)
 }
 
 // Println logs message as `INFO`. Arguments handled in the mananer of `fmt.Printf`.
 func (l *Logger) Println(format string, v ...interface{}) {
-	l.output(LevelInfo, 3, &format, v...)
+	l.output(LevelInfo, 3, &format, nil, nil, v...)
 }
 
 // Fatal logs message as `FATAL` and call to os.Exit(1).
 func (l *Logger) Fatal(v ...interface{}) {
-	l.output(levelFatal, 3, nil, v...)
+	l.output(levelFatal, 3, nil, nil, nil, v...)
 	exit(1)
 }
 
 // Fatalf logs message as `FATAL` and call to os.Exit(1).
 func (l *Logger) Fatalf(format string, v ...interface{}) {
-	l.output(levelFatal, 3, &format, v...)
+	l.output(levelFatal, 3, &format, nil, nil, v...)
 	exit(1)
 }
 
 // Fatalln logs message as `FATAL` and call to os.Exit(1).
 func (l *Logger) Fatalln(format string, v ...interface{}) {
-	l.output(levelFatal, 3, &format, v...)
+	l.output(levelFatal, 3, &format, nil, nil, v...)
 	exit(1)
 }
 
 // Panic logs message as `PANIC` and call to panic().
 func (l *Logger) Panic(v ...interface{}) {
-	l.output(levelPanic, 3, nil, v...)
+	l.output(levelPanic, 3, nil, nil, nil, v...)
 	panic("")
 }
 
 // Panicf logs message as `PANIC` and call to panic().
 func (l *Logger) Panicf(format string, v ...interface{}) {
-	l.output(levelPanic, 3, &format, v...)
+	l.output(levelPanic, 3, &format, nil, nil, v...)
 	panic(fmt.Sprintf(format, v...))
 }
 
 // Panicln logs message as `PANIC` and call to panic().
 func (l *Logger) Panicln(format string, v ...interface{}) {
-	l.output(levelPanic, 3, &format, v...)
+	l.output(levelPanic, 3, &format, nil, nil, v...)
 	panic(fmt.Sprintf(format, v...))
 }
 
@@ -310,7 +327,7 @@ func (l *Logger) IsLevelTrace() bool {
 
 // output method checks the level, formats the arguments and call to configured
 // Log receivers.
-func (l *Logger) output(level level, calldepth int, format *string, v ...interface{}) {
+func (l *Logger) output(level level, calldepth int, format *string, fields Fields, err error, v ...interface{}) {
 	if level > l.level {
 		return
 	}
@@ -324,6 +341,8 @@ func (l *Logger) output(level level, calldepth int, format *string, v ...interfa
 	} else {
 		entry.Message = fmt.Sprintf(*format, v...)
 	}
+	entry.Fields = fields
+	entry.Error = err
 
 	if l.receiver.IsCallerInfo() {
 		entry.File, entry.Line = fetchCallerInfo(calldepth)
@@ -0,0 +1,111 @@
+// Copyright (c) Jeevanandam M (https://github.com/jeevatkm)
+// go-aah/log source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package log
+
+import (
+	"strconv"
+	"strings"
+)
+
+// DefaultPattern is the pattern a plain-text, pattern-dependent receiver
+// (e.g. `FileReceiver`) uses when `log.pattern` isn't configured.
+const DefaultPattern = "%time{2006-01-02 15:04:05.000} %level{-5} %message"
+
+// patternDirectives maps a directive name (the identifier right after
+// `%`) to a function rendering that directive's value for the given
+// `Entry` into b. An optional `{arg}` immediately follows the name, e.g.
+// `%time{2006-01-02}` or `%level{-5}` (a field width, as per `fmt`).
+var patternDirectives = map[string]func(b *strings.Builder, e *Entry, arg string){
+	"time": func(b *strings.Builder, e *Entry, arg string) {
+		layout := arg
+		if layout == "" {
+			layout = "2006-01-02 15:04:05.000"
+		}
+		b.WriteString(e.Time.Format(layout))
+	},
+	"level": func(b *strings.Builder, e *Entry, arg string) {
+		name := levelToLevelName[e.Level]
+		if width, err := strconv.Atoi(arg); err == nil {
+			name = padToWidth(name, width)
+		}
+		b.WriteString(name)
+	},
+	"message": func(b *strings.Builder, e *Entry, arg string) {
+		b.WriteString(e.Message)
+	},
+	"file": func(b *strings.Builder, e *Entry, arg string) {
+		b.WriteString(e.File)
+	},
+	"line": func(b *strings.Builder, e *Entry, arg string) {
+		b.WriteString(strconv.Itoa(e.Line))
+	},
+}
+
+// padToWidth pads s to |width| with spaces - left-padded (right-aligned)
+// for a positive width, right-padded (left-aligned) for negative, mirroring
+// `fmt`'s `%5s`/`%-5s` verbs.
+func padToWidth(s string, width int) string {
+	w := width
+	left := false
+	if w < 0 {
+		w = -w
+		left = true
+	}
+	if len(s) >= w {
+		return s
+	}
+	pad := strings.Repeat(" ", w-len(s))
+	if left {
+		return s + pad
+	}
+	return pad + s
+}
+
+// formatEntry renders e according to pattern. Directives look like
+// `%name` or `%name{arg}` (e.g. `%time{2006-01-02}`, `%level{-5}`,
+// `%message`); anything else, including whitespace, is copied through
+// literally, and an unrecognized directive name is emitted unchanged.
+// The rendered line is newline-terminated.
+func formatEntry(pattern string, e *Entry) []byte {
+	var b strings.Builder
+
+	i := 0
+	for i < len(pattern) {
+		if pattern[i] != '%' {
+			b.WriteByte(pattern[i])
+			i++
+			continue
+		}
+
+		i++ // consume '%'
+		start := i
+		for i < len(pattern) && isDirectiveChar(pattern[i]) {
+			i++
+		}
+		name := pattern[start:i]
+
+		arg := ""
+		if i < len(pattern) && pattern[i] == '{' {
+			if end := strings.IndexByte(pattern[i:], '}'); end >= 0 {
+				arg = pattern[i+1 : i+end]
+				i += end + 1
+			}
+		}
+
+		if fn, ok := patternDirectives[name]; ok {
+			fn(&b, e, arg)
+		} else {
+			b.WriteByte('%')
+			b.WriteString(name)
+		}
+	}
+
+	b.WriteByte('\n')
+	return []byte(b.String())
+}
+
+func isDirectiveChar(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
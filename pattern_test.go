@@ -0,0 +1,30 @@
+// Copyright (c) Jeevanandam M (https://github.com/jeevatkm)
+// go-aah/log source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package log
+
+import (
+	"testing"
+	"time"
+
+	"aahframework.org/test.v0/assert"
+)
+
+func TestFormatEntryDirectives(t *testing.T) {
+	e := &Entry{
+		Time:    time.Date(2016, 7, 3, 19, 22, 11, 0, time.UTC),
+		Level:   LevelInfo,
+		Message: "Welcome to aah logger",
+	}
+
+	out := string(formatEntry(DefaultPattern, e))
+	assert.Equal(t, "2016-07-03 19:22:11.000 INFO  Welcome to aah logger\n", out)
+}
+
+func TestFormatEntryUnknownDirective(t *testing.T) {
+	e := &Entry{Message: "hi"}
+
+	out := string(formatEntry("%bogus %message", e))
+	assert.Equal(t, "%bogus hi\n", out)
+}
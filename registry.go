@@ -0,0 +1,36 @@
+// Copyright (c) Jeevanandam M (https://github.com/jeevatkm)
+// go-aah/log source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package log
+
+import "sync"
+
+var (
+	registryMu sync.RWMutex
+	registry   = make(map[string]*Logger)
+)
+
+// Register method registers the given `Logger` under `name` in the
+// package-level registry, so it can be discovered later (by the aah admin
+// `/aah/loggers` endpoints, for e.g.) and have its level changed at
+// runtime without a restart. Registering under a name already in use
+// replaces the previous entry.
+func Register(name string, l *Logger) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	registry[name] = l
+}
+
+// Loggers method returns a snapshot of all currently registered loggers,
+// keyed by the name they were `Register`ed under.
+func Loggers() map[string]*Logger {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	loggers := make(map[string]*Logger, len(registry))
+	for name, l := range registry {
+		loggers[name] = l
+	}
+	return loggers
+}